@@ -0,0 +1,69 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// @SDKDataSource("aws_sagemaker_model_package")
+func DataSourceModelPackage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceModelPackageRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"model_package_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"model_package_group_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"model_package_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"model_package_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"model_approval_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceModelPackageRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SageMakerConn
+
+	name := d.Get("name").(string)
+
+	modelPackage, err := finder.ModelPackageByName(conn, name)
+	if err != nil {
+		return fmt.Errorf("reading SageMaker Model Package (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(modelPackage.ModelPackageArn))
+	d.Set("arn", modelPackage.ModelPackageArn)
+	d.Set("model_package_name", modelPackage.ModelPackageName)
+	d.Set("model_package_group_name", modelPackage.ModelPackageGroupName)
+	d.Set("model_package_description", modelPackage.ModelPackageDescription)
+	d.Set("model_package_version", modelPackage.ModelPackageVersion)
+	d.Set("model_approval_status", modelPackage.ModelApprovalStatus)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// @SDKDataSource("aws_sagemaker_model_package_group")
+func DataSourceModelPackageGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceModelPackageGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"model_package_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"model_package_group_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"model_package_group_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceModelPackageGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SageMakerConn
+
+	name := d.Get("model_package_group_name").(string)
+
+	modelPackageGroup, err := finder.ModelPackageGroupByName(conn, name)
+	if err != nil {
+		return fmt.Errorf("reading SageMaker Model Package Group (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(modelPackageGroup.ModelPackageGroupArn))
+	d.Set("arn", modelPackageGroup.ModelPackageGroupArn)
+	d.Set("model_package_group_description", modelPackageGroup.ModelPackageGroupDescription)
+	d.Set("model_package_group_status", modelPackageGroup.ModelPackageGroupStatus)
+
+	return nil
+}
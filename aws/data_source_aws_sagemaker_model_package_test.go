@@ -0,0 +1,41 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAWSSagemakerModelPackageDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_model_package.test"
+	dataSourceName := "data.aws_sagemaker_model_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerModelPackageDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "model_package_name", resourceName, "model_package_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "model_package_description", resourceName, "model_package_description"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSSagemakerModelPackageDataSourceConfig(rName string) string {
+	return testAccAWSSagemakerModelPackageBasicConfig(rName) + `
+data "aws_sagemaker_model_package" "test" {
+  name = aws_sagemaker_model_package.test.model_package_name
+}
+`
+}
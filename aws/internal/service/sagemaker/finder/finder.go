@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package finder
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/aws/internal/tfresource"
+)
+
+// ModelPackageByName returns the model package corresponding to the specified name.
+func ModelPackageByName(conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeModelPackageOutput, error) {
+	input := &sagemaker.DescribeModelPackageInput{
+		ModelPackageName: aws.String(name),
+	}
+
+	output, err := conn.DescribeModelPackage(input)
+
+	if tfawserr.ErrMessageContains(err, sagemaker.ErrCodeResourceNotFound, "") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// ModelPackageGroupByName returns the model package group corresponding to the specified name.
+func ModelPackageGroupByName(conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeModelPackageGroupOutput, error) {
+	input := &sagemaker.DescribeModelPackageGroupInput{
+		ModelPackageGroupName: aws.String(name),
+	}
+
+	output, err := conn.DescribeModelPackageGroup(input)
+
+	if tfawserr.ErrMessageContains(err, sagemaker.ErrCodeResourceNotFound, "") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
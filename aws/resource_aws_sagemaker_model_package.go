@@ -0,0 +1,1103 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+	"github.com/hashicorp/terraform-provider-aws/aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_sagemaker_model_package")
+func ResourceModelPackage() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceModelPackageCreate,
+		ReadWithoutTimeout:   resourceModelPackageRead,
+		UpdateWithoutTimeout: resourceModelPackageUpdate,
+		DeleteWithoutTimeout: resourceModelPackageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"model_package_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 63),
+					validation.StringMatch(regexache.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9])*$`), "Valid characters are a-z, A-Z, 0-9, and - (hyphen)."),
+				),
+			},
+			"model_package_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 63),
+					validation.StringMatch(regexache.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9])*$`), "Valid characters are a-z, A-Z, 0-9, and - (hyphen)."),
+				),
+			},
+			"model_package_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"model_package_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"model_approval_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(sagemaker.ModelApprovalStatus_Values(), false),
+			},
+			"domain": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"task": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"sample_payload_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"certify_for_marketplace": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"customer_metadata_properties": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"additional_inference_specifications": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 15,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 63),
+						},
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"containers":                                  inferenceContainerSchema(),
+						"supported_content_types":                     {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+						"supported_response_mime_types":               {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+						"supported_realtime_inference_instance_types": {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+						"supported_transform_instance_types":          {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+					},
+				},
+			},
+			"inference_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"containers": inferenceContainerSchema(),
+						"supported_content_types": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"supported_response_mime_types": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"supported_realtime_inference_instance_types": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"supported_transform_instance_types": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"source_algorithm_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_algorithm": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"algorithm_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 170),
+									},
+									"model_data_url": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"validation_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"validation_role": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"validation_profile": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"profile_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 63),
+									},
+									"transform_job_definition": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"max_concurrent_transforms": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"max_payload_in_mb": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"transform_input": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"data_source": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"s3_data_source": {
+																			Type:     schema.TypeList,
+																			Required: true,
+																			MaxItems: 1,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"s3_data_type": {
+																						Type:         schema.TypeString,
+																						Required:     true,
+																						ValidateFunc: validation.StringInSlice(sagemaker.S3DataType_Values(), false),
+																					},
+																					"s3_uri": {
+																						Type:         schema.TypeString,
+																						Required:     true,
+																						ValidateFunc: validation.StringLenBetween(1, 1024),
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+												"transform_output": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"s3_output_path": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 1024),
+															},
+														},
+													},
+												},
+												"transform_resources": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"instance_count": {
+																Type:     schema.TypeInt,
+																Required: true,
+															},
+															"instance_type": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"model_metrics": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bias":               metricsReportGroupSchema(),
+						"explainability":     metricsReportGroupSchema(),
+						"model_data_quality": metricsStatisticsGroupSchema(),
+						"model_quality":      metricsStatisticsGroupSchema(),
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func inferenceContainerSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 15,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"image": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+				"container_hostname": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 63),
+				},
+				"model_data_url": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				"product_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+			},
+		},
+	}
+}
+
+// metricsReportGroupSchema models the Bias/Explainability shape, which carries
+// a single "report" metrics source.
+func metricsReportGroupSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"report": metricsSourceSchema(),
+			},
+		},
+	}
+}
+
+// metricsStatisticsGroupSchema models the ModelQuality/ModelDataQuality shape,
+// which carries "statistics" and "constraints" metrics sources.
+func metricsStatisticsGroupSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"statistics":  metricsSourceSchema(),
+				"constraints": metricsSourceSchema(),
+			},
+		},
+	}
+}
+
+func metricsSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"content_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+				"s3_uri": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+			},
+		},
+	}
+}
+
+func resourceModelPackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SageMakerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
+
+	input := &sagemaker.CreateModelPackageInput{}
+
+	if v, ok := d.GetOk("model_package_name"); ok {
+		input.ModelPackageName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("model_package_group_name"); ok {
+		input.ModelPackageGroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("model_package_description"); ok {
+		input.ModelPackageDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("model_approval_status"); ok {
+		input.ModelApprovalStatus = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("domain"); ok {
+		input.Domain = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("task"); ok {
+		input.Task = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sample_payload_url"); ok {
+		input.SamplePayloadUrl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("certify_for_marketplace"); ok {
+		input.CertifyForMarketplace = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("customer_metadata_properties"); ok {
+		input.CustomerMetadataProperties = expandSagemakerModelPackageCustomerMetadataProperties(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("inference_specification"); ok && len(v.([]interface{})) > 0 {
+		input.InferenceSpecification = expandSagemakerModelPackageInferenceSpecification(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("additional_inference_specifications"); ok && len(v.([]interface{})) > 0 {
+		input.AdditionalInferenceSpecifications = expandSagemakerModelPackageAdditionalInferenceSpecifications(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("source_algorithm_specification"); ok && len(v.([]interface{})) > 0 {
+		input.SourceAlgorithmSpecification = expandSagemakerModelPackageSourceAlgorithmSpecification(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("validation_specification"); ok && len(v.([]interface{})) > 0 {
+		input.ValidationSpecification = expandSagemakerModelPackageValidationSpecification(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("model_metrics"); ok && len(v.([]interface{})) > 0 {
+		input.ModelMetrics = expandSagemakerModelPackageModelMetrics(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = sagemakerTags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating SageMaker Model Package: %s", input)
+	output, err := conn.CreateModelPackageWithContext(ctx, input)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("creating SageMaker Model Package: %w", err))
+	}
+
+	d.SetId(aws.StringValue(output.ModelPackageArn))
+
+	return resourceModelPackageRead(ctx, d, meta)
+}
+
+func resourceModelPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SageMakerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	modelPackage, err := finder.ModelPackageByName(conn, d.Id())
+	if err != nil {
+		if tfresource.NotFound(err) {
+			log.Printf("[WARN] SageMaker Model Package (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("reading SageMaker Model Package (%s): %w", d.Id(), err))
+	}
+
+	arn := aws.StringValue(modelPackage.ModelPackageArn)
+	d.Set("arn", arn)
+	d.Set("model_package_name", modelPackage.ModelPackageName)
+	d.Set("model_package_group_name", modelPackage.ModelPackageGroupName)
+	d.Set("model_package_description", modelPackage.ModelPackageDescription)
+	d.Set("model_package_version", modelPackage.ModelPackageVersion)
+	d.Set("model_approval_status", modelPackage.ModelApprovalStatus)
+	d.Set("domain", modelPackage.Domain)
+	d.Set("task", modelPackage.Task)
+	d.Set("sample_payload_url", modelPackage.SamplePayloadUrl)
+	d.Set("certify_for_marketplace", modelPackage.CertifyForMarketplace)
+
+	if err := d.Set("customer_metadata_properties", aws.StringValueMap(modelPackage.CustomerMetadataProperties)); err != nil {
+		return diag.FromErr(fmt.Errorf("setting customer_metadata_properties: %w", err))
+	}
+
+	if err := d.Set("inference_specification", flattenSagemakerModelPackageInferenceSpecification(modelPackage.InferenceSpecification)); err != nil {
+		return diag.FromErr(fmt.Errorf("setting inference_specification: %w", err))
+	}
+
+	if err := d.Set("additional_inference_specifications", flattenSagemakerModelPackageAdditionalInferenceSpecifications(modelPackage.AdditionalInferenceSpecifications)); err != nil {
+		return diag.FromErr(fmt.Errorf("setting additional_inference_specifications: %w", err))
+	}
+
+	if err := d.Set("source_algorithm_specification", flattenSagemakerModelPackageSourceAlgorithmSpecification(modelPackage.SourceAlgorithmSpecification)); err != nil {
+		return diag.FromErr(fmt.Errorf("setting source_algorithm_specification: %w", err))
+	}
+
+	if err := d.Set("validation_specification", flattenSagemakerModelPackageValidationSpecification(modelPackage.ValidationSpecification)); err != nil {
+		return diag.FromErr(fmt.Errorf("setting validation_specification: %w", err))
+	}
+
+	if err := d.Set("model_metrics", flattenSagemakerModelPackageModelMetrics(modelPackage.ModelMetrics)); err != nil {
+		return diag.FromErr(fmt.Errorf("setting model_metrics: %w", err))
+	}
+
+	tags, err := sagemakerListTags(ctx, conn, arn)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("listing tags for SageMaker Model Package (%s): %w", arn, err))
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("setting tags: %w", err))
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("setting tags_all: %w", err))
+	}
+
+	return nil
+}
+
+func resourceModelPackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SageMakerConn
+
+	if d.HasChanges("model_approval_status", "customer_metadata_properties") {
+		input := &sagemaker.UpdateModelPackageInput{
+			ModelPackageArn: aws.String(d.Get("arn").(string)),
+		}
+
+		if v, ok := d.GetOk("model_approval_status"); ok {
+			input.ModelApprovalStatus = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("customer_metadata_properties"); ok {
+			input.CustomerMetadataProperties = expandSagemakerModelPackageCustomerMetadataProperties(v.(map[string]interface{}))
+		}
+
+		if d.HasChange("customer_metadata_properties") {
+			o, n := d.GetChange("customer_metadata_properties")
+			if removed := sagemakerRemovedCustomerMetadataPropertyKeys(o.(map[string]interface{}), n.(map[string]interface{})); len(removed) > 0 {
+				input.CustomerMetadataPropertiesToRemove = aws.StringSlice(removed)
+			}
+		}
+
+		if _, err := conn.UpdateModelPackageWithContext(ctx, input); err != nil {
+			return diag.FromErr(fmt.Errorf("updating SageMaker Model Package (%s): %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := sagemakerUpdateTags(ctx, conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("updating tags for SageMaker Model Package (%s): %w", d.Id(), err))
+		}
+	}
+
+	return resourceModelPackageRead(ctx, d, meta)
+}
+
+func resourceModelPackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SageMakerConn
+
+	_, err := conn.DeleteModelPackageWithContext(ctx, &sagemaker.DeleteModelPackageInput{
+		ModelPackageName: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		if tfresource.NotFound(err) {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("deleting SageMaker Model Package (%s): %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+// sagemakerRemovedCustomerMetadataPropertyKeys returns the keys present in the
+// old customer_metadata_properties map that are no longer present in the new one.
+func sagemakerRemovedCustomerMetadataPropertyKeys(o, n map[string]interface{}) []string {
+	var removed []string
+	for k := range o {
+		if _, ok := n[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	return removed
+}
+
+func expandSagemakerModelPackageCustomerMetadataProperties(m map[string]interface{}) map[string]*string {
+	if len(m) == 0 {
+		return nil
+	}
+
+	return flex.ExpandStringMap(m)
+}
+
+func expandSagemakerModelPackageContainers(l []interface{}) []*sagemaker.ModelPackageContainerDefinition {
+	if len(l) == 0 {
+		return nil
+	}
+
+	containers := make([]*sagemaker.ModelPackageContainerDefinition, 0, len(l))
+
+	for _, v := range l {
+		tfMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		container := &sagemaker.ModelPackageContainerDefinition{
+			Image: aws.String(tfMap["image"].(string)),
+		}
+
+		if v, ok := tfMap["container_hostname"].(string); ok && v != "" {
+			container.ContainerHostname = aws.String(v)
+		}
+
+		if v, ok := tfMap["model_data_url"].(string); ok && v != "" {
+			container.ModelDataUrl = aws.String(v)
+		}
+
+		if v, ok := tfMap["product_id"].(string); ok && v != "" {
+			container.ProductId = aws.String(v)
+		}
+
+		containers = append(containers, container)
+	}
+
+	return containers
+}
+
+func flattenSagemakerModelPackageContainers(containers []*sagemaker.ModelPackageContainerDefinition) []map[string]interface{} {
+	if len(containers) == 0 {
+		return []map[string]interface{}{}
+	}
+
+	l := make([]map[string]interface{}, 0, len(containers))
+
+	for _, container := range containers {
+		l = append(l, map[string]interface{}{
+			"image":              aws.StringValue(container.Image),
+			"container_hostname": aws.StringValue(container.ContainerHostname),
+			"model_data_url":     aws.StringValue(container.ModelDataUrl),
+			"product_id":         aws.StringValue(container.ProductId),
+		})
+	}
+
+	return l
+}
+
+func expandSagemakerModelPackageInferenceSpecification(l []interface{}) *sagemaker.InferenceSpecification {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := l[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	spec := &sagemaker.InferenceSpecification{
+		Containers: expandSagemakerModelPackageContainers(tfMap["containers"].([]interface{})),
+	}
+
+	if v, ok := tfMap["supported_content_types"].([]interface{}); ok && len(v) > 0 {
+		spec.SupportedContentTypes = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["supported_response_mime_types"].([]interface{}); ok && len(v) > 0 {
+		spec.SupportedResponseMIMETypes = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["supported_realtime_inference_instance_types"].([]interface{}); ok && len(v) > 0 {
+		spec.SupportedRealtimeInferenceInstanceTypes = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["supported_transform_instance_types"].([]interface{}); ok && len(v) > 0 {
+		spec.SupportedTransformInstanceTypes = flex.ExpandStringList(v)
+	}
+
+	return spec
+}
+
+func flattenSagemakerModelPackageInferenceSpecification(spec *sagemaker.InferenceSpecification) []map[string]interface{} {
+	if spec == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"containers":                                  flattenSagemakerModelPackageContainers(spec.Containers),
+		"supported_content_types":                     aws.StringValueSlice(spec.SupportedContentTypes),
+		"supported_response_mime_types":               aws.StringValueSlice(spec.SupportedResponseMIMETypes),
+		"supported_realtime_inference_instance_types": aws.StringValueSlice(spec.SupportedRealtimeInferenceInstanceTypes),
+		"supported_transform_instance_types":          aws.StringValueSlice(spec.SupportedTransformInstanceTypes),
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func expandSagemakerModelPackageAdditionalInferenceSpecifications(l []interface{}) []*sagemaker.AdditionalInferenceSpecificationDefinition {
+	if len(l) == 0 {
+		return nil
+	}
+
+	specs := make([]*sagemaker.AdditionalInferenceSpecificationDefinition, 0, len(l))
+
+	for _, v := range l {
+		tfMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		spec := &sagemaker.AdditionalInferenceSpecificationDefinition{
+			Name:       aws.String(tfMap["name"].(string)),
+			Containers: expandSagemakerModelPackageContainers(tfMap["containers"].([]interface{})),
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			spec.Description = aws.String(v)
+		}
+
+		if v, ok := tfMap["supported_content_types"].([]interface{}); ok && len(v) > 0 {
+			spec.SupportedContentTypes = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["supported_response_mime_types"].([]interface{}); ok && len(v) > 0 {
+			spec.SupportedResponseMIMETypes = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["supported_realtime_inference_instance_types"].([]interface{}); ok && len(v) > 0 {
+			spec.SupportedRealtimeInferenceInstanceTypes = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["supported_transform_instance_types"].([]interface{}); ok && len(v) > 0 {
+			spec.SupportedTransformInstanceTypes = flex.ExpandStringList(v)
+		}
+
+		specs = append(specs, spec)
+	}
+
+	return specs
+}
+
+func flattenSagemakerModelPackageAdditionalInferenceSpecifications(specs []*sagemaker.AdditionalInferenceSpecificationDefinition) []map[string]interface{} {
+	if len(specs) == 0 {
+		return []map[string]interface{}{}
+	}
+
+	l := make([]map[string]interface{}, 0, len(specs))
+
+	for _, spec := range specs {
+		l = append(l, map[string]interface{}{
+			"name":                          aws.StringValue(spec.Name),
+			"description":                   aws.StringValue(spec.Description),
+			"containers":                    flattenSagemakerModelPackageContainers(spec.Containers),
+			"supported_content_types":       aws.StringValueSlice(spec.SupportedContentTypes),
+			"supported_response_mime_types": aws.StringValueSlice(spec.SupportedResponseMIMETypes),
+			"supported_realtime_inference_instance_types": aws.StringValueSlice(spec.SupportedRealtimeInferenceInstanceTypes),
+			"supported_transform_instance_types":          aws.StringValueSlice(spec.SupportedTransformInstanceTypes),
+		})
+	}
+
+	return l
+}
+
+func expandSagemakerModelPackageSourceAlgorithmSpecification(l []interface{}) *sagemaker.SourceAlgorithmSpecification {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := l[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	algoList := tfMap["source_algorithm"].([]interface{})
+	if len(algoList) == 0 || algoList[0] == nil {
+		return nil
+	}
+
+	algoMap := algoList[0].(map[string]interface{})
+
+	algo := &sagemaker.SourceAlgorithm{
+		AlgorithmName: aws.String(algoMap["algorithm_name"].(string)),
+	}
+
+	if v, ok := algoMap["model_data_url"].(string); ok && v != "" {
+		algo.ModelDataUrl = aws.String(v)
+	}
+
+	return &sagemaker.SourceAlgorithmSpecification{
+		SourceAlgorithms: []*sagemaker.SourceAlgorithm{algo},
+	}
+}
+
+func flattenSagemakerModelPackageSourceAlgorithmSpecification(spec *sagemaker.SourceAlgorithmSpecification) []map[string]interface{} {
+	if spec == nil || len(spec.SourceAlgorithms) == 0 {
+		return []map[string]interface{}{}
+	}
+
+	algo := spec.SourceAlgorithms[0]
+
+	algoMap := map[string]interface{}{
+		"algorithm_name": aws.StringValue(algo.AlgorithmName),
+		"model_data_url": aws.StringValue(algo.ModelDataUrl),
+	}
+
+	return []map[string]interface{}{
+		{"source_algorithm": []map[string]interface{}{algoMap}},
+	}
+}
+
+func expandSagemakerModelPackageValidationSpecification(l []interface{}) *sagemaker.ModelPackageValidationSpecification {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := l[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	spec := &sagemaker.ModelPackageValidationSpecification{
+		ValidationRole: aws.String(tfMap["validation_role"].(string)),
+	}
+
+	profileList := tfMap["validation_profile"].([]interface{})
+	if len(profileList) == 0 || profileList[0] == nil {
+		return spec
+	}
+
+	profileMap := profileList[0].(map[string]interface{})
+
+	profile := &sagemaker.ModelPackageValidationProfile{
+		ProfileName: aws.String(profileMap["profile_name"].(string)),
+	}
+
+	tjdList := profileMap["transform_job_definition"].([]interface{})
+	if len(tjdList) > 0 && tjdList[0] != nil {
+		profile.TransformJobDefinition = expandSagemakerModelPackageTransformJobDefinition(tjdList[0].(map[string]interface{}))
+	}
+
+	spec.ValidationProfiles = []*sagemaker.ModelPackageValidationProfile{profile}
+
+	return spec
+}
+
+func expandSagemakerModelPackageTransformJobDefinition(tfMap map[string]interface{}) *sagemaker.TransformJobDefinition {
+	tjd := &sagemaker.TransformJobDefinition{}
+
+	if v, ok := tfMap["max_concurrent_transforms"].(int); ok && v > 0 {
+		tjd.MaxConcurrentTransforms = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["max_payload_in_mb"].(int); ok && v > 0 {
+		tjd.MaxPayloadInMB = aws.Int64(int64(v))
+	}
+
+	if l, ok := tfMap["transform_input"].([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		inputMap := l[0].(map[string]interface{})
+		dataSourceList := inputMap["data_source"].([]interface{})
+		if len(dataSourceList) > 0 && dataSourceList[0] != nil {
+			dataSourceMap := dataSourceList[0].(map[string]interface{})
+			s3List := dataSourceMap["s3_data_source"].([]interface{})
+			if len(s3List) > 0 && s3List[0] != nil {
+				s3Map := s3List[0].(map[string]interface{})
+				tjd.TransformInput = &sagemaker.TransformInput{
+					DataSource: &sagemaker.TransformDataSource{
+						S3DataSource: &sagemaker.TransformS3DataSource{
+							S3DataType: aws.String(s3Map["s3_data_type"].(string)),
+							S3Uri:      aws.String(s3Map["s3_uri"].(string)),
+						},
+					},
+				}
+			}
+		}
+	}
+
+	if l, ok := tfMap["transform_output"].([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		outputMap := l[0].(map[string]interface{})
+		tjd.TransformOutput = &sagemaker.TransformOutput{
+			S3OutputPath: aws.String(outputMap["s3_output_path"].(string)),
+		}
+	}
+
+	if l, ok := tfMap["transform_resources"].([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		resourcesMap := l[0].(map[string]interface{})
+		tjd.TransformResources = &sagemaker.TransformResources{
+			InstanceCount: aws.Int64(int64(resourcesMap["instance_count"].(int))),
+			InstanceType:  aws.String(resourcesMap["instance_type"].(string)),
+		}
+	}
+
+	return tjd
+}
+
+func flattenSagemakerModelPackageValidationSpecification(spec *sagemaker.ModelPackageValidationSpecification) []map[string]interface{} {
+	if spec == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"validation_role": aws.StringValue(spec.ValidationRole),
+	}
+
+	if len(spec.ValidationProfiles) > 0 {
+		profile := spec.ValidationProfiles[0]
+		profileMap := map[string]interface{}{
+			"profile_name": aws.StringValue(profile.ProfileName),
+		}
+
+		if tjd := profile.TransformJobDefinition; tjd != nil {
+			tjdMap := map[string]interface{}{}
+
+			if tjd.MaxConcurrentTransforms != nil {
+				tjdMap["max_concurrent_transforms"] = aws.Int64Value(tjd.MaxConcurrentTransforms)
+			}
+
+			if tjd.MaxPayloadInMB != nil {
+				tjdMap["max_payload_in_mb"] = aws.Int64Value(tjd.MaxPayloadInMB)
+			}
+
+			if ti := tjd.TransformInput; ti != nil && ti.DataSource != nil && ti.DataSource.S3DataSource != nil {
+				tjdMap["transform_input"] = []map[string]interface{}{
+					{"data_source": []map[string]interface{}{
+						{"s3_data_source": []map[string]interface{}{
+							{
+								"s3_data_type": aws.StringValue(ti.DataSource.S3DataSource.S3DataType),
+								"s3_uri":       aws.StringValue(ti.DataSource.S3DataSource.S3Uri),
+							},
+						}},
+					}},
+				}
+			}
+
+			if to := tjd.TransformOutput; to != nil {
+				tjdMap["transform_output"] = []map[string]interface{}{
+					{"s3_output_path": aws.StringValue(to.S3OutputPath)},
+				}
+			}
+
+			if tr := tjd.TransformResources; tr != nil {
+				tjdMap["transform_resources"] = []map[string]interface{}{
+					{
+						"instance_count": aws.Int64Value(tr.InstanceCount),
+						"instance_type":  aws.StringValue(tr.InstanceType),
+					},
+				}
+			}
+
+			profileMap["transform_job_definition"] = []map[string]interface{}{tjdMap}
+		}
+
+		m["validation_profile"] = []map[string]interface{}{profileMap}
+	}
+
+	return []map[string]interface{}{m}
+}
+
+func expandSagemakerModelPackageMetricsSource(l []interface{}) *sagemaker.MetricsSource {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap := l[0].(map[string]interface{})
+
+	return &sagemaker.MetricsSource{
+		ContentType: aws.String(tfMap["content_type"].(string)),
+		S3Uri:       aws.String(tfMap["s3_uri"].(string)),
+	}
+}
+
+func flattenSagemakerModelPackageMetricsSource(source *sagemaker.MetricsSource) []map[string]interface{} {
+	if source == nil {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{
+		{
+			"content_type": aws.StringValue(source.ContentType),
+			"s3_uri":       aws.StringValue(source.S3Uri),
+		},
+	}
+}
+
+func expandSagemakerModelPackageModelMetrics(l []interface{}) *sagemaker.ModelMetrics {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	tfMap, ok := l[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	metrics := &sagemaker.ModelMetrics{}
+
+	if v, ok := tfMap["bias"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		reportMap := v[0].(map[string]interface{})
+		metrics.Bias = &sagemaker.Bias{
+			Report: expandSagemakerModelPackageMetricsSource(reportMap["report"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["explainability"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		reportMap := v[0].(map[string]interface{})
+		metrics.Explainability = &sagemaker.Explainability{
+			Report: expandSagemakerModelPackageMetricsSource(reportMap["report"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["model_data_quality"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		statsMap := v[0].(map[string]interface{})
+		metrics.ModelDataQuality = &sagemaker.ModelDataQuality{
+			Statistics:  expandSagemakerModelPackageMetricsSource(statsMap["statistics"].([]interface{})),
+			Constraints: expandSagemakerModelPackageMetricsSource(statsMap["constraints"].([]interface{})),
+		}
+	}
+
+	if v, ok := tfMap["model_quality"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		statsMap := v[0].(map[string]interface{})
+		metrics.ModelQuality = &sagemaker.ModelQuality{
+			Statistics:  expandSagemakerModelPackageMetricsSource(statsMap["statistics"].([]interface{})),
+			Constraints: expandSagemakerModelPackageMetricsSource(statsMap["constraints"].([]interface{})),
+		}
+	}
+
+	return metrics
+}
+
+func flattenSagemakerModelPackageModelMetrics(metrics *sagemaker.ModelMetrics) []map[string]interface{} {
+	if metrics == nil {
+		return []map[string]interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if metrics.Bias != nil {
+		m["bias"] = []map[string]interface{}{
+			{"report": flattenSagemakerModelPackageMetricsSource(metrics.Bias.Report)},
+		}
+	}
+
+	if metrics.Explainability != nil {
+		m["explainability"] = []map[string]interface{}{
+			{"report": flattenSagemakerModelPackageMetricsSource(metrics.Explainability.Report)},
+		}
+	}
+
+	if metrics.ModelDataQuality != nil {
+		m["model_data_quality"] = []map[string]interface{}{
+			{
+				"statistics":  flattenSagemakerModelPackageMetricsSource(metrics.ModelDataQuality.Statistics),
+				"constraints": flattenSagemakerModelPackageMetricsSource(metrics.ModelDataQuality.Constraints),
+			},
+		}
+	}
+
+	if metrics.ModelQuality != nil {
+		m["model_quality"] = []map[string]interface{}{
+			{
+				"statistics":  flattenSagemakerModelPackageMetricsSource(metrics.ModelQuality.Statistics),
+				"constraints": flattenSagemakerModelPackageMetricsSource(metrics.ModelQuality.Constraints),
+			},
+		}
+	}
+
+	return []map[string]interface{}{m}
+}
@@ -0,0 +1,128 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+	"github.com/hashicorp/terraform-provider-aws/aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestAccAWSSagemakerModelPackage_basic(t *testing.T) {
+	var mp sagemaker.DescribeModelPackageOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_model_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAWSSagemakerModelPackageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerModelPackageBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerModelPackageExists(resourceName, &mp),
+					resource.TestCheckResourceAttr(resourceName, "model_package_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "inference_specification.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerModelPackage_disappears(t *testing.T) {
+	var mp sagemaker.DescribeModelPackageOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_model_package.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAWSSagemakerModelPackageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerModelPackageBasicConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerModelPackageExists(resourceName, &mp),
+					acctest.CheckResourceDisappears(acctest.Provider, ResourceModelPackage(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSagemakerModelPackageDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_model_package" {
+			continue
+		}
+
+		_, err := finder.ModelPackageByName(conn, rs.Primary.ID)
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading SageMaker Model Package (%s): %w", rs.Primary.ID, err)
+		}
+
+		return fmt.Errorf("SageMaker Model Package %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSSagemakerModelPackageExists(n string, mp *sagemaker.DescribeModelPackageOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker Model Package ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn
+		resp, err := finder.ModelPackageByName(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		*mp = *resp
+
+		return nil
+	}
+}
+
+func testAccAWSSagemakerModelPackageBasicConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_sagemaker_model_package" "test" {
+  model_package_name        = %[1]q
+  model_package_description = "test model package"
+
+  inference_specification {
+    containers {
+      image = "763104351884.dkr.ecr.${data.aws_region.current.name}.amazonaws.com/sklearn-inference:0.23-1-cpu-py3"
+    }
+
+    supported_content_types        = ["text/csv"]
+    supported_response_mime_types  = ["text/csv"]
+  }
+}
+`, rName)
+}
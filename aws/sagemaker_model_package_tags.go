@@ -0,0 +1,68 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func sagemakerTags(tags tftags.KeyValueTags) []*sagemaker.Tag {
+	result := make([]*sagemaker.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		result = append(result, &sagemaker.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	return result
+}
+
+func sagemakerKeyValueTags(ctx context.Context, tags []*sagemaker.Tag) tftags.KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return tftags.New(ctx, m)
+}
+
+func sagemakerListTags(ctx context.Context, conn *sagemaker.SageMaker, arn string) (tftags.KeyValueTags, error) {
+	output, err := conn.ListTagsWithContext(ctx, &sagemaker.ListTagsInput{
+		ResourceArn: aws.String(arn),
+	})
+	if err != nil {
+		return tftags.New(ctx, nil), err
+	}
+
+	return sagemakerKeyValueTags(ctx, output.Tags), nil
+}
+
+func sagemakerUpdateTags(ctx context.Context, conn *sagemaker.SageMaker, arn string, oldTagsMap, newTagsMap interface{}) error {
+	oldTags := tftags.New(ctx, oldTagsMap)
+	newTags := tftags.New(ctx, newTagsMap)
+
+	if removedTags := oldTags.Removed(newTags); len(removedTags) > 0 {
+		if _, err := conn.DeleteTagsWithContext(ctx, &sagemaker.DeleteTagsInput{
+			ResourceArn: aws.String(arn),
+			TagKeys:     aws.StringSlice(removedTags.IgnoreAWS().Keys()),
+		}); err != nil {
+			return err
+		}
+	}
+
+	if updatedTags := oldTags.Updated(newTags); len(updatedTags) > 0 {
+		if _, err := conn.AddTagsWithContext(ctx, &sagemaker.AddTagsInput{
+			ResourceArn: aws.String(arn),
+			Tags:        sagemakerTags(updatedTags.IgnoreAWS()),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -15,11 +16,15 @@ import (
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_ssm_association")
@@ -37,6 +42,8 @@ func ResourceAssociation() *schema.Resource {
 		MigrateState:  AssociationMigrateState,
 		SchemaVersion: 1,
 
+		CustomizeDiff: verify.SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -105,7 +112,7 @@ func ResourceAssociation() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"s3_bucket_name": {
 							Type:         schema.TypeString,
-							Required:     true,
+							Optional:     true,
 							ValidateFunc: validation.StringLenBetween(3, 63),
 						},
 						"s3_key_prefix": {
@@ -118,14 +125,34 @@ func ResourceAssociation() *schema.Resource {
 							Optional:     true,
 							ValidateFunc: validation.StringLenBetween(3, 20),
 						},
+						"cloud_watch_output_config": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cloud_watch_log_group_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"cloud_watch_output_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 			"targets": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Computed: true,
-				MaxItems: 5,
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      5,
+				ConflictsWith: []string{"target_locations"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"key": {
@@ -142,6 +169,43 @@ func ResourceAssociation() *schema.Resource {
 					},
 				},
 			},
+			"target_locations": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      5,
+				ConflictsWith: []string{"targets"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accounts": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 50,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"regions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 50,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"target_location_max_concurrency": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+						},
+						"target_location_max_errors": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringMatch(regexache.MustCompile(`^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+						},
+						"execution_role_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+					},
+				},
+			},
 			"compliance_severity": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -156,13 +220,35 @@ func ResourceAssociation() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"sync_compliance": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ssm.AssociationSyncCompliance_Values(), false),
+			},
+			"calendar_names": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"wait_for_deletion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
 
+const associationDeletedTimeout = 10 * time.Minute
+
 func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
 	log.Printf("[DEBUG] SSM association create: %s", d.Id())
 
@@ -198,6 +284,10 @@ func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta
 		associationInput.Targets = expandTargets(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("target_locations"); ok {
+		associationInput.TargetLocations = expandTargetLocations(v.([]interface{}))
+	}
+
 	if v, ok := d.GetOk("output_location"); ok {
 		associationInput.OutputLocation = expandAssociationOutputLocation(v.([]interface{}))
 	}
@@ -218,6 +308,18 @@ func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta
 		associationInput.AutomationTargetParameterName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("sync_compliance"); ok {
+		associationInput.SyncCompliance = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("calendar_names"); ok {
+		associationInput.CalendarNames = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if len(tags) > 0 {
+		associationInput.Tags = Tags(tags.IgnoreAWS())
+	}
+
 	resp, err := conn.CreateAssociationWithContext(ctx, associationInput)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating SSM association: %s", err)
@@ -233,7 +335,7 @@ func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta
 		dur, _ := time.ParseDuration(fmt.Sprintf("%ds", v.(int)))
 		_, err = waitAssociationSuccess(ctx, conn, d.Id(), dur)
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for SSM Association (%s) to be Success: %s", d.Id(), err)
+			return sdkdiag.AppendErrorf(diags, "waiting for SSM Association (%s) to be Success: %s%s", d.Id(), err, associationExecutionTargetFailures(ctx, conn, d.Id()))
 		}
 	}
 
@@ -243,6 +345,8 @@ func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta
 func resourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSMConn(ctx)
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	log.Printf("[DEBUG] Reading SSM Association: %s", d.Id())
 
@@ -275,6 +379,11 @@ func resourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("max_concurrency", association.MaxConcurrency)
 	d.Set("max_errors", association.MaxErrors)
 	d.Set("automation_target_parameter_name", association.AutomationTargetParameterName)
+	d.Set("sync_compliance", association.SyncCompliance)
+
+	if err := d.Set("calendar_names", aws.StringValueSlice(association.CalendarNames)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting calendar_names error: %s", err)
+	}
 
 	if err := d.Set("parameters", flattenParameters(association.Parameters)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading SSM Association (%s): %s", d.Id(), err)
@@ -284,10 +393,29 @@ func resourceAssociationRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "setting targets error: %s", err)
 	}
 
+	if err := d.Set("target_locations", flattenTargetLocations(association.TargetLocations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting target_locations error: %s", err)
+	}
+
 	if err := d.Set("output_location", flattenAssociationOutputLocation(association.OutputLocation)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting output_location error: %s", err)
 	}
 
+	tags, err := ListTags(ctx, conn, d.Id(), ssm.ResourceTypeForTaggingAssociation)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for SSM Association (%s): %s", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
 	return diags
 }
 
@@ -326,6 +454,10 @@ func resourceAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta
 		associationInput.Targets = expandTargets(d.Get("targets").([]interface{}))
 	}
 
+	if d.HasChange("target_locations") {
+		associationInput.TargetLocations = expandTargetLocations(d.Get("target_locations").([]interface{}))
+	}
+
 	if v, ok := d.GetOk("output_location"); ok {
 		associationInput.OutputLocation = expandAssociationOutputLocation(v.([]interface{}))
 	}
@@ -346,11 +478,40 @@ func resourceAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta
 		associationInput.AutomationTargetParameterName = aws.String(v.(string))
 	}
 
+	if d.HasChange("sync_compliance") {
+		if v := d.Get("sync_compliance").(string); v != "" {
+			associationInput.SyncCompliance = aws.String(v)
+		} else {
+			// sync_compliance has no zero value on the API side; clearing it in
+			// config means falling back to AWS's own default of AUTO.
+			associationInput.SyncCompliance = aws.String(ssm.AssociationSyncComplianceAuto)
+		}
+	}
+
+	if d.HasChange("calendar_names") {
+		associationInput.CalendarNames = flex.ExpandStringList(d.Get("calendar_names").([]interface{}))
+	}
+
 	_, err := conn.UpdateAssociationWithContext(ctx, associationInput)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating SSM association: %s", err)
 	}
 
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Id(), ssm.ResourceTypeForTaggingAssociation, o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating tags for SSM Association (%s): %s", d.Id(), err)
+		}
+	}
+
+	if v, ok := d.GetOk("wait_for_success_timeout_seconds"); ok {
+		dur, _ := time.ParseDuration(fmt.Sprintf("%ds", v.(int)))
+		if _, err := waitAssociationSuccess(ctx, conn, d.Id(), dur); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for SSM Association (%s) to be Success after update: %s%s", d.Id(), err, associationExecutionTargetFailures(ctx, conn, d.Id()))
+		}
+	}
+
 	return append(diags, resourceAssociationRead(ctx, d, meta)...)
 }
 
@@ -373,9 +534,81 @@ func resourceAssociationDelete(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "deleting SSM association: %s", err)
 	}
 
+	if d.Get("wait_for_deletion").(bool) {
+		if err := waitAssociationDeleted(ctx, conn, d.Id(), associationDeletedTimeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for SSM Association (%s) to be deleted: %s%s", d.Id(), err, associationExecutionTargetFailures(ctx, conn, d.Id()))
+		}
+	}
+
 	return diags
 }
 
+func waitAssociationDeleted(ctx context.Context, conn *ssm.SSM, id string, timeout time.Duration) error {
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		_, err := conn.DescribeAssociationWithContext(ctx, &ssm.DescribeAssociationInput{
+			AssociationId: aws.String(id),
+		})
+
+		if tfawserr.ErrCodeContains(err, ssm.ErrCodeAssociationDoesNotExist) {
+			return nil
+		}
+
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		return retry.RetryableError(fmt.Errorf("SSM Association (%s) still exists", id))
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.DescribeAssociationWithContext(ctx, &ssm.DescribeAssociationInput{
+			AssociationId: aws.String(id),
+		})
+	}
+
+	if tfawserr.ErrCodeContains(err, ssm.ErrCodeAssociationDoesNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+// associationExecutionTargetFailures returns a human-readable summary of
+// per-target failure reasons for the most recent execution of the given
+// association, for use in enriching wait-timeout diagnostics. It returns an
+// empty string if no failure detail is available.
+func associationExecutionTargetFailures(ctx context.Context, conn *ssm.SSM, id string) string {
+	execOutput, err := conn.DescribeAssociationExecutionsWithContext(ctx, &ssm.DescribeAssociationExecutionsInput{
+		AssociationId: aws.String(id),
+		MaxResults:    aws.Int64(1),
+	})
+	if err != nil || len(execOutput.AssociationExecutions) == 0 {
+		return ""
+	}
+
+	targetsOutput, err := conn.DescribeAssociationExecutionTargetsWithContext(ctx, &ssm.DescribeAssociationExecutionTargetsInput{
+		AssociationId: aws.String(id),
+		ExecutionId:   execOutput.AssociationExecutions[0].ExecutionId,
+	})
+	if err != nil || len(targetsOutput.AssociationExecutionTargets) == 0 {
+		return ""
+	}
+
+	var failures []string
+	for _, target := range targetsOutput.AssociationExecutionTargets {
+		if aws.StringValue(target.Status) == ssm.AssociationStatusNameSuccess {
+			continue
+		}
+		failures = append(failures, fmt.Sprintf("%s: %s - %s", aws.StringValue(target.ResourceId), aws.StringValue(target.Status), aws.StringValue(target.DetailedStatus)))
+	}
+
+	if len(failures) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("\n\ntarget failures:\n%s", strings.Join(failures, "\n"))
+}
+
 func expandDocumentParameters(params map[string]interface{}) map[string][]*string {
 	var docParams = make(map[string][]*string)
 	for k, v := range params {
@@ -387,6 +620,72 @@ func expandDocumentParameters(params map[string]interface{}) map[string][]*strin
 	return docParams
 }
 
+func expandTargetLocations(targetLocations []interface{}) []*ssm.TargetLocation {
+	// Return a non-nil, empty slice (rather than nil) when targetLocations is
+	// empty so that clearing target_locations on update actually puts an empty
+	// list on the wire instead of being omitted like an unset field.
+	result := make([]*ssm.TargetLocation, 0, len(targetLocations))
+
+	for _, tl := range targetLocations {
+		tlConfig, ok := tl.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		targetLocation := &ssm.TargetLocation{
+			Accounts: flex.ExpandStringList(tlConfig["accounts"].([]interface{})),
+			Regions:  flex.ExpandStringList(tlConfig["regions"].([]interface{})),
+		}
+
+		if v, ok := tlConfig["target_location_max_concurrency"].(string); ok && v != "" {
+			targetLocation.TargetLocationMaxConcurrency = aws.String(v)
+		}
+
+		if v, ok := tlConfig["target_location_max_errors"].(string); ok && v != "" {
+			targetLocation.TargetLocationMaxErrors = aws.String(v)
+		}
+
+		if v, ok := tlConfig["execution_role_name"].(string); ok && v != "" {
+			targetLocation.ExecutionRoleName = aws.String(v)
+		}
+
+		result = append(result, targetLocation)
+	}
+
+	return result
+}
+
+func flattenTargetLocations(targetLocations []*ssm.TargetLocation) []map[string]interface{} {
+	if len(targetLocations) == 0 {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(targetLocations))
+
+	for _, tl := range targetLocations {
+		item := map[string]interface{}{
+			"accounts": flex.FlattenStringList(tl.Accounts),
+			"regions":  flex.FlattenStringList(tl.Regions),
+		}
+
+		if tl.TargetLocationMaxConcurrency != nil {
+			item["target_location_max_concurrency"] = aws.StringValue(tl.TargetLocationMaxConcurrency)
+		}
+
+		if tl.TargetLocationMaxErrors != nil {
+			item["target_location_max_errors"] = aws.StringValue(tl.TargetLocationMaxErrors)
+		}
+
+		if tl.ExecutionRoleName != nil {
+			item["execution_role_name"] = aws.StringValue(tl.ExecutionRoleName)
+		}
+
+		result = append(result, item)
+	}
+
+	return result
+}
+
 func expandAssociationOutputLocation(config []interface{}) *ssm.InstanceAssociationOutputLocation {
 	if config == nil {
 		return nil
@@ -395,41 +694,65 @@ func expandAssociationOutputLocation(config []interface{}) *ssm.InstanceAssociat
 	//We only allow 1 Item so we can grab the first in the list only
 	locationConfig := config[0].(map[string]interface{})
 
-	S3OutputLocation := &ssm.S3OutputLocation{
-		OutputS3BucketName: aws.String(locationConfig["s3_bucket_name"].(string)),
-	}
+	output := &ssm.InstanceAssociationOutputLocation{}
 
-	if v, ok := locationConfig["s3_key_prefix"]; ok {
-		S3OutputLocation.OutputS3KeyPrefix = aws.String(v.(string))
-	}
+	if v, ok := locationConfig["s3_bucket_name"].(string); ok && v != "" {
+		S3OutputLocation := &ssm.S3OutputLocation{
+			OutputS3BucketName: aws.String(v),
+		}
 
-	if v, ok := locationConfig["s3_region"].(string); ok && v != "" {
-		S3OutputLocation.OutputS3Region = aws.String(v)
+		if v, ok := locationConfig["s3_key_prefix"]; ok {
+			S3OutputLocation.OutputS3KeyPrefix = aws.String(v.(string))
+		}
+
+		if v, ok := locationConfig["s3_region"].(string); ok && v != "" {
+			S3OutputLocation.OutputS3Region = aws.String(v)
+		}
+
+		output.S3Location = S3OutputLocation
 	}
 
-	return &ssm.InstanceAssociationOutputLocation{
-		S3Location: S3OutputLocation,
+	if v, ok := locationConfig["cloud_watch_output_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		cloudWatchConfig := v[0].(map[string]interface{})
+
+		output.CloudWatchOutputConfig = &ssm.CloudWatchOutputConfig{
+			CloudWatchLogGroupName:  aws.String(cloudWatchConfig["cloud_watch_log_group_name"].(string)),
+			CloudWatchOutputEnabled: aws.Bool(cloudWatchConfig["cloud_watch_output_enabled"].(bool)),
+		}
 	}
+
+	return output
 }
 
 func flattenAssociationOutputLocation(location *ssm.InstanceAssociationOutputLocation) []map[string]interface{} {
-	if location == nil || location.S3Location == nil {
+	if location == nil || (location.S3Location == nil && location.CloudWatchOutputConfig == nil) {
 		return nil
 	}
 
-	result := make([]map[string]interface{}, 0)
 	item := make(map[string]interface{})
 
-	item["s3_bucket_name"] = aws.StringValue(location.S3Location.OutputS3BucketName)
+	if location.S3Location != nil {
+		item["s3_bucket_name"] = aws.StringValue(location.S3Location.OutputS3BucketName)
 
-	if location.S3Location.OutputS3KeyPrefix != nil {
-		item["s3_key_prefix"] = aws.StringValue(location.S3Location.OutputS3KeyPrefix)
+		if location.S3Location.OutputS3KeyPrefix != nil {
+			item["s3_key_prefix"] = aws.StringValue(location.S3Location.OutputS3KeyPrefix)
+		}
+
+		if location.S3Location.OutputS3Region != nil {
+			item["s3_region"] = aws.StringValue(location.S3Location.OutputS3Region)
+		}
 	}
 
-	if location.S3Location.OutputS3Region != nil {
-		item["s3_region"] = aws.StringValue(location.S3Location.OutputS3Region)
+	if location.CloudWatchOutputConfig != nil {
+		item["cloud_watch_output_config"] = []map[string]interface{}{
+			{
+				"cloud_watch_log_group_name": aws.StringValue(location.CloudWatchOutputConfig.CloudWatchLogGroupName),
+				"cloud_watch_output_enabled": aws.BoolValue(location.CloudWatchOutputConfig.CloudWatchOutputEnabled),
+			},
+		}
 	}
 
+	result := make([]map[string]interface{}, 0, 1)
 	result = append(result, item)
 
 	return result
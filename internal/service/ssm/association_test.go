@@ -0,0 +1,357 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSSMAssociation_complianceAndTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var assoc ssm.AssociationDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationComplianceAndTagsConfig(rName, "AUTO", "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName, &assoc),
+					resource.TestCheckResourceAttr(resourceName, "sync_compliance", "AUTO"),
+					resource.TestCheckResourceAttr(resourceName, "calendar_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+				),
+			},
+			{
+				Config: testAccAssociationComplianceAndTagsConfig(rName, "MANUAL", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName, &assoc),
+					resource.TestCheckResourceAttr(resourceName, "sync_compliance", "MANUAL"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccAssociationComplianceClearedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName, &assoc),
+					resource.TestCheckResourceAttr(resourceName, "calendar_names.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_association" {
+				continue
+			}
+
+			_, err := conn.DescribeAssociationWithContext(ctx, &ssm.DescribeAssociationInput{
+				AssociationId: aws.String(rs.Primary.ID),
+			})
+			if tfresource.NotFound(err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SSM Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAssociationExists(ctx context.Context, n string, v *ssm.AssociationDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMConn(ctx)
+
+		output, err := conn.DescribeAssociationWithContext(ctx, &ssm.DescribeAssociationInput{
+			AssociationId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*v = *output.AssociationDescription
+
+		return nil
+	}
+}
+
+func testAccAssociationComplianceAndTagsConfig(rName, syncCompliance, tagKey, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_document" "calendar" {
+  name            = "%[1]s-calendar"
+  document_type   = "ChangeCalendar"
+  document_format = "TEXT"
+  content         = "# %[1]s calendar\nDefault Open\n"
+}
+
+resource "aws_ssm_association" "test" {
+  name             = "AWS-RunShellScript"
+  sync_compliance  = %[2]q
+  calendar_names   = [aws_ssm_document.calendar.name]
+
+  targets {
+    key    = "tag:Name"
+    values = [%[1]q]
+  }
+
+  parameters = {
+    commands = "echo %[1]s"
+  }
+
+  tags = {
+    %[3]s = %[4]q
+  }
+}
+`, rName, syncCompliance, tagKey, tagValue)
+}
+
+func testAccAssociationComplianceClearedConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_association" "test" {
+  name = "AWS-RunShellScript"
+
+  targets {
+    key    = "tag:Name"
+    values = [%[1]q]
+  }
+
+  parameters = {
+    commands = "echo %[1]s"
+  }
+}
+`, rName)
+}
+
+func TestAccSSMAssociation_targetLocations(t *testing.T) {
+	ctx := acctest.Context(t)
+	var assoc ssm.AssociationDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationTargetLocationsConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName, &assoc),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.accounts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.0.regions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "targets.#", "0"),
+				),
+			},
+			{
+				Config: testAccAssociationTargetLocationsClearedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName, &assoc),
+					resource.TestCheckResourceAttr(resourceName, "target_locations.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAssociationTargetLocationsConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_ssm_association" "test" {
+  name             = "AWS-RunShellScript"
+  association_name = %[1]q
+
+  target_locations {
+    accounts = [data.aws_caller_identity.current.account_id]
+    regions  = ["us-east-1"]
+  }
+
+  parameters = {
+    commands = "echo %[1]s"
+  }
+}
+`, rName)
+}
+
+func testAccAssociationTargetLocationsClearedConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_association" "test" {
+  name             = "AWS-RunShellScript"
+  association_name = %[1]q
+
+  targets {
+    key    = "tag:Name"
+    values = [%[1]q]
+  }
+
+  parameters = {
+    commands = "echo %[1]s"
+  }
+}
+`, rName)
+}
+
+func TestAccSSMAssociation_waitForSuccessAndDeletion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var assoc ssm.AssociationDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationWaitForSuccessAndDeletionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName, &assoc),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_success_timeout_seconds", "600"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_deletion", "true"),
+				),
+			},
+			{
+				// Re-applying the same config with a new wait_for_success_timeout_seconds
+				// forces an update, exercising the post-update wait in
+				// resourceAssociationUpdate as well as the initial post-create wait.
+				Config: testAccAssociationWaitForSuccessAndDeletionUpdatedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName, &assoc),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_success_timeout_seconds", "900"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAssociationWaitForSuccessAndDeletionConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_association" "test" {
+  name                             = "AWS-RunShellScript"
+  association_name                 = %[1]q
+  wait_for_success_timeout_seconds = 600
+  wait_for_deletion                = true
+
+  targets {
+    key    = "tag:Name"
+    values = [%[1]q]
+  }
+
+  parameters = {
+    commands = "echo %[1]s"
+  }
+}
+`, rName)
+}
+
+func testAccAssociationWaitForSuccessAndDeletionUpdatedConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_association" "test" {
+  name                             = "AWS-RunShellScript"
+  association_name                 = %[1]q
+  wait_for_success_timeout_seconds = 900
+  wait_for_deletion                = true
+
+  targets {
+    key    = "tag:Name"
+    values = [%[1]q]
+  }
+
+  parameters = {
+    commands = "echo %[1]s"
+  }
+}
+`, rName)
+}
+
+func TestAccSSMAssociation_cloudWatchOutputConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var assoc ssm.AssociationDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociationCloudWatchOutputConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssociationExists(ctx, resourceName, &assoc),
+					resource.TestCheckResourceAttr(resourceName, "output_location.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "output_location.0.cloud_watch_output_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "output_location.0.cloud_watch_output_config.0.cloud_watch_log_group_name", "aws_cloudwatch_log_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "output_location.0.cloud_watch_output_config.0.cloud_watch_output_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAssociationCloudWatchOutputConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_ssm_association" "test" {
+  name = "AWS-RunShellScript"
+
+  targets {
+    key    = "tag:Name"
+    values = [%[1]q]
+  }
+
+  parameters = {
+    commands = "echo %[1]s"
+  }
+
+  output_location {
+    cloud_watch_output_config {
+      cloud_watch_log_group_name = aws_cloudwatch_log_group.test.name
+      cloud_watch_output_enabled = true
+    }
+  }
+}
+`, rName)
+}